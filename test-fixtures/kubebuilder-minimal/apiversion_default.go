@@ -0,0 +1,8 @@
+//go:build !v1alpha1 && !v1alpha2 && !v1alpha3
+
+package main
+
+// apiVersion identifies which generated API version this binary was built
+// against. No version build tag was supplied, so this binary defaults to
+// the latest supported version.
+const apiVersion = "v1alpha3"
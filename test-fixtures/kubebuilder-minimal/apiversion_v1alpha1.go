@@ -0,0 +1,7 @@
+//go:build v1alpha1
+
+package main
+
+// apiVersion identifies which generated API version this binary was built
+// against. Select it at build time with `go build -tags v1alpha1`.
+const apiVersion = "v1alpha1"
@@ -0,0 +1,17 @@
+package main
+
+import ctrl "sigs.k8s.io/controller-runtime"
+
+func init() {
+	RegisterReconciler("example", (&ExampleReconciler{}).SetupWithManager)
+}
+
+// ExampleReconciler is a no-op reconciler that demonstrates how to plug a
+// controller into the shared Manager via RegisterReconciler. It exists
+// only as a template for downstream reconcilers.
+type ExampleReconciler struct{}
+
+// SetupWithManager is a no-op: it sets up no watches on mgr and returns nil.
+func (r *ExampleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return nil
+}
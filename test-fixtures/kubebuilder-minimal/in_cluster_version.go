@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	inClusterVersionNamespace  = "kube-system"
+	inClusterVersionConfigMap  = "kubebuilder-minimal-install-info"
+	inClusterVersionAnnotation = "kubebuilder-minimal.io/version"
+	inClusterVersionTimeout    = 2 * time.Second
+)
+
+// lookupInClusterVersion reads the running controller's version from a
+// ConfigMap annotation written at install time, so the CLI banner can
+// report the build's own version alongside the version actually deployed
+// in the cluster. It returns an empty string when no kubeconfig is
+// available, the API server can't be reached within
+// inClusterVersionTimeout, or the ConfigMap doesn't exist, since this is a
+// best-effort addition to the banner rather than something callers should
+// fail on or block on indefinitely.
+func lookupInClusterVersion(ctx context.Context) string {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return ""
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, inClusterVersionTimeout)
+	defer cancel()
+
+	cm, err := clientset.CoreV1().ConfigMaps(inClusterVersionNamespace).Get(ctx, inClusterVersionConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return ""
+	}
+
+	return cm.Annotations[inClusterVersionAnnotation]
+}
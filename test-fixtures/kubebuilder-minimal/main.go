@@ -2,8 +2,18 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"kubebuilder-minimal/pkg/scaffold"
 )
 
 // Version information set via ldflags
@@ -13,7 +23,16 @@ var (
 )
 
 func main() {
-	fmt.Printf("kubebuilder-minimal version %s (commit: %s)\n", version, commit)
+	fmt.Printf("kubebuilder-minimal version %s (api %s, commit: %s)\n", version, apiVersion, commit)
+
+	if len(os.Args) > 1 && os.Args[1] == "scaffold" {
+		if err := scaffold.Run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
@@ -21,18 +40,66 @@ func main() {
 }
 
 func run() error {
-	// In a real kubebuilder project, this would set up the controller manager
-	// For this minimal example, we just demonstrate the structure
+	var (
+		metricsAddr          string
+		probeAddr            string
+		enableLeaderElection bool
+		leaderElectionID     string
+		mode                 string
+	)
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "Enable leader election for controller manager.")
+	flag.StringVar(&leaderElectionID, "leader-election-id", "kubebuilder-minimal-lock", "The name of the resource used for leader election.")
+	flag.StringVar(&mode, "mode", "runtime", "Controller stack to run: \"runtime\" for the full controller-runtime Manager, or \"simple\" for the lightweight simplecontroller stack.")
+	flag.Parse()
+
+	ctx := signals.SetupSignalHandler()
+
+	// Only the controller-running modes talk to a cluster; a local
+	// "scaffold" invocation already returned from main before reaching
+	// here, so this is a controller startup, not a codegen run.
+	if inClusterVersion := lookupInClusterVersion(ctx); inClusterVersion != "" {
+		fmt.Printf("controller in-cluster version: %s\n", inClusterVersion)
+	}
+
+	if mode == "simple" {
+		return runSimpleMode(ctx)
+	}
+
+	ctrl.SetLogger(zap.New())
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress: probeAddr,
+		LeaderElection:         enableLeaderElection,
+		LeaderElectionID:       leaderElectionID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start manager: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to set up health check: %w", err)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		return fmt.Errorf("unable to set up ready check: %w", err)
+	}
+
+	if err := SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to set up controllers: %w", err)
+	}
+
 	fmt.Println("Starting controller manager...")
+	if err := mgr.Start(ctx); err != nil {
+		return fmt.Errorf("problem running manager: %w", err)
+	}
 	return nil
 }
 
-// Add returns the sum of two integers
-func Add(a, b int) int {
-	return a + b
-}
-
-// Multiply returns the product of two integers
-func Multiply(a, b int) int {
-	return a * b
+// SetupWithManager registers every reconciler in the reconciler registry
+// with the shared Manager. Callers that import this module can add their
+// own CRD reconcilers via RegisterReconciler without editing main.go.
+func SetupWithManager(mgr ctrl.Manager) error {
+	return setupRegisteredReconcilers(mgr)
 }
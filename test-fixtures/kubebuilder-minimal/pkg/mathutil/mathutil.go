@@ -0,0 +1,15 @@
+// Package mathutil provides small arithmetic helpers. It is laid out as a
+// minimal pkg/<name> example: one file, a package doc comment, and a
+// couple of exported functions. The scaffold generator does not read,
+// copy, or reference this package.
+package mathutil
+
+// Add returns the sum of two integers.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Multiply returns the product of two integers.
+func Multiply(a, b int) int {
+	return a * b
+}
@@ -0,0 +1,241 @@
+// Package scaffold generates the simplified builder-based API and
+// controller layout used by the "scaffold" subcommand: a single types.go
+// per group/version, a generated deepcopy file, a controller skeleton,
+// and scheme registration wired up automatically via init().
+package scaffold
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Run parses the scaffold subcommand's arguments and dispatches to the
+// requested generator. Currently only "api" is supported.
+func Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: scaffold api --group <group> --version <version> --kind <kind>")
+	}
+
+	switch args[0] {
+	case "api":
+		return runAPI(args[1:])
+	default:
+		return fmt.Errorf("unknown scaffold target %q", args[0])
+	}
+}
+
+// apiData carries the values substituted into the API and controller
+// templates.
+type apiData struct {
+	Group   string
+	Version string
+	Kind    string
+}
+
+func runAPI(args []string) error {
+	fs := flag.NewFlagSet("scaffold api", flag.ContinueOnError)
+	group := fs.String("group", "", "API group, e.g. \"foo\"")
+	version := fs.String("version", "", "API version, e.g. \"v1\"")
+	kind := fs.String("kind", "", "Kind name, e.g. \"Widget\"")
+	force := fs.Bool("force", false, "Overwrite files a developer may have hand-edited since the last scaffold run.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *group == "" || *version == "" || *kind == "" {
+		return fmt.Errorf("--group, --version, and --kind are all required")
+	}
+
+	data := apiData{Group: *group, Version: *version, Kind: *kind}
+	apiDir := filepath.Join("pkg", "apis", data.Group, data.Version)
+
+	if err := writeTemplate(filepath.Join(apiDir, "groupversion_info.go"), groupVersionTemplate, data, *force); err != nil {
+		return err
+	}
+	if err := writeTemplate(filepath.Join(apiDir, "types.go"), typesTemplate, data, *force); err != nil {
+		return err
+	}
+	// Always regenerated: its header already says "DO NOT EDIT".
+	if err := writeTemplate(filepath.Join(apiDir, "zz_generated_deepcopy.go"), deepcopyTemplate, data, true); err != nil {
+		return err
+	}
+
+	controllerPath := filepath.Join("pkg", "controllers", strings.ToLower(data.Kind)+"_controller.go")
+	if err := writeTemplate(controllerPath, controllerTemplate, data, *force); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// writeTemplate renders body to path. Unless overwrite is true, it refuses
+// to touch a file that already exists, so re-running the scaffold command
+// doesn't clobber hand-edits a developer made to previously generated
+// code; pass --force to overwrite anyway.
+func writeTemplate(path, body string, data apiData, overwrite bool) error {
+	if !overwrite {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists; pass --force to overwrite", path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	t, err := template.New(filepath.Base(path)).Parse(body)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return t.Execute(f, data)
+}
+
+const groupVersionTemplate = `// Package {{.Version}} contains API Schema definitions for the
+// {{.Group}} {{.Version}} API group.
+package {{.Version}}
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	// GroupVersion is the group version used to register these objects.
+	GroupVersion = schema.GroupVersion{Group: "{{.Group}}", Version: "{{.Version}}"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	// AddToScheme adds the types in this group-version to the given scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+`
+
+const typesTemplate = `package {{.Version}}
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+func init() {
+	SchemeBuilder.Register(&{{.Kind}}{}, &{{.Kind}}List{})
+}
+
+// {{.Kind}} is the Schema for the {{.Kind}} API.
+type {{.Kind}} struct {
+	metav1.TypeMeta   ` + "`json:\",inline\"`" + `
+	metav1.ObjectMeta ` + "`json:\"metadata,omitempty\"`" + `
+}
+
+// {{.Kind}}List contains a list of {{.Kind}}.
+type {{.Kind}}List struct {
+	metav1.TypeMeta ` + "`json:\",inline\"`" + `
+	metav1.ListMeta ` + "`json:\"metadata,omitempty\"`" + `
+	Items           []{{.Kind}} ` + "`json:\"items\"`" + `
+}
+`
+
+const deepcopyTemplate = `// Code generated by scaffold. DO NOT EDIT.
+
+package {{.Version}}
+
+import "k8s.io/apimachinery/pkg/runtime"
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *{{.Kind}}) DeepCopyInto(out *{{.Kind}}) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new {{.Kind}}.
+func (in *{{.Kind}}) DeepCopy() *{{.Kind}} {
+	if in == nil {
+		return nil
+	}
+	out := new({{.Kind}})
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *{{.Kind}}) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *{{.Kind}}List) DeepCopyInto(out *{{.Kind}}List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		l := make([]{{.Kind}}, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&l[i])
+		}
+		out.Items = l
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new {{.Kind}}List.
+func (in *{{.Kind}}List) DeepCopy() *{{.Kind}}List {
+	if in == nil {
+		return nil
+	}
+	out := new({{.Kind}}List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is a deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *{{.Kind}}List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+`
+
+const controllerTemplate = `// Package controllers contains reconcilers for the {{.Group}} API group.
+package controllers
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	{{.Version}} "kubebuilder-minimal/pkg/apis/{{.Group}}/{{.Version}}"
+)
+
+// {{.Kind}}Reconciler reconciles a {{.Kind}} object.
+type {{.Kind}}Reconciler struct {
+	client.Client
+}
+
+// Reconcile implements the reconcile loop for {{.Kind}}.
+func (r *{{.Kind}}Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var obj {{.Version}}.{{.Kind}}
+	if err := r.Get(ctx, req.NamespacedName, &obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the {{.Kind}}Reconciler with the given Manager.
+func (r *{{.Kind}}Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&{{.Version}}.{{.Kind}}{}).
+		Complete(r)
+}
+`
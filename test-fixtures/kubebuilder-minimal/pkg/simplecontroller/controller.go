@@ -0,0 +1,112 @@
+// Package simplecontroller provides a lightweight, client-go style
+// alternative to a full controller-runtime Manager for operators that
+// don't need its caching, webhook, and leader-election machinery.
+package simplecontroller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// SyncFunc reconciles a single object identified by key ("namespace/name").
+type SyncFunc func(ctx context.Context, key string) error
+
+// Controller is a minimal, informer-driven controller: it watches a set of
+// SharedIndexInformers, enqueues changed keys onto a rate-limited
+// workqueue, and calls SyncFunc for each one on a pool of workers.
+type Controller struct {
+	name      string
+	informers []cache.SharedIndexInformer
+	sync      SyncFunc
+	queue     workqueue.RateLimitingInterface
+}
+
+// NewController builds a Controller that watches the given informers and
+// calls sync for every key that changes.
+func NewController(name string, informers []cache.SharedIndexInformer, sync SyncFunc) *Controller {
+	c := &Controller{
+		name:      name,
+		informers: informers,
+		sync:      sync,
+		queue:     workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+		DeleteFunc: c.enqueue,
+	}
+	for _, informer := range informers {
+		informer.AddEventHandler(handler)
+	}
+
+	return c
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts the informers, waits for their caches to sync, and then runs
+// workers worker goroutines until ctx is cancelled. On cancellation it
+// waits for in-flight work to drain before returning.
+func (c *Controller) Run(ctx context.Context, workers int) error {
+	stopCh := ctx.Done()
+	synced := make([]cache.InformerSynced, 0, len(c.informers))
+	for _, informer := range c.informers {
+		go informer.Run(stopCh)
+		synced = append(synced, informer.HasSynced)
+	}
+
+	if !cache.WaitForCacheSync(stopCh, synced...) {
+		return fmt.Errorf("%s: timed out waiting for caches to sync", c.name)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for c.processNextItem(ctx) {
+			}
+		}()
+	}
+
+	<-ctx.Done()
+	// Unblock any worker parked in queue.Get() before waiting for them to
+	// drain, or wg.Wait() below would hang forever.
+	c.queue.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+// processNextItem handles a single queue item, reporting whether the
+// caller should keep pulling more work.
+func (c *Controller) processNextItem(ctx context.Context) bool {
+	item, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(item)
+
+	key, ok := item.(string)
+	if !ok {
+		c.queue.Forget(item)
+		return true
+	}
+
+	if err := c.sync(ctx, key); err != nil {
+		c.queue.AddRateLimited(item)
+		return true
+	}
+	c.queue.Forget(item)
+	return true
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// ReconcilerSetupFunc registers a reconciler's watches and event handlers
+// against the shared Manager.
+type ReconcilerSetupFunc func(mgr ctrl.Manager) error
+
+var reconcilerRegistry = map[string]ReconcilerSetupFunc{}
+
+// RegisterReconciler adds a named reconciler setup function to the
+// registry. setupRegisteredReconcilers invokes every registered function
+// against the shared Manager before mgr.Start is called, so other Go
+// modules importing this one can add their own CRD reconcilers without
+// forking main.go.
+func RegisterReconciler(name string, setup ReconcilerSetupFunc) {
+	reconcilerRegistry[name] = setup
+}
+
+// setupRegisteredReconcilers calls every reconciler setup function in the
+// registry against mgr.
+func setupRegisteredReconcilers(mgr ctrl.Manager) error {
+	for name, setup := range reconcilerRegistry {
+		if err := setup(mgr); err != nil {
+			return fmt.Errorf("unable to set up reconciler %q: %w", name, err)
+		}
+	}
+	return nil
+}
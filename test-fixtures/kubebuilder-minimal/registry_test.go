@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// fakeManager is a stand-in for ctrl.Manager that lets tests assert on
+// manager identity without standing up a real Manager.
+type fakeManager struct {
+	ctrl.Manager
+}
+
+func TestRegisterReconciler(t *testing.T) {
+	original := reconcilerRegistry
+	reconcilerRegistry = map[string]ReconcilerSetupFunc{}
+	defer func() { reconcilerRegistry = original }()
+
+	mgr := &fakeManager{}
+	calls := 0
+	var gotMgr ctrl.Manager
+
+	RegisterReconciler("fake", func(m ctrl.Manager) error {
+		calls++
+		gotMgr = m
+		return nil
+	})
+
+	if err := setupRegisteredReconcilers(mgr); err != nil {
+		t.Fatalf("setupRegisteredReconcilers returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected setup to be called exactly once, got %d", calls)
+	}
+	if gotMgr != ctrl.Manager(mgr) {
+		t.Fatalf("expected setup to receive the shared Manager, got a different value")
+	}
+}
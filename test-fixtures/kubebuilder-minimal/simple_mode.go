@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"kubebuilder-minimal/pkg/simplecontroller"
+)
+
+// runSimpleMode runs the lightweight simplecontroller stack instead of a
+// full controller-runtime Manager, for operators that don't need the
+// Manager's leader election, webhook, and cache machinery.
+func runSimpleMode(ctx context.Context) error {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("unable to load kubeconfig: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("unable to build clientset: %w", err)
+	}
+
+	factory := informers.NewSharedInformerFactory(clientset, 0)
+	podInformer := factory.Core().V1().Pods().Informer()
+
+	c := simplecontroller.NewController(
+		"kubebuilder-minimal",
+		[]cache.SharedIndexInformer{podInformer},
+		func(ctx context.Context, key string) error { return nil },
+	)
+
+	// Controller.Run starts and waits on the informers itself; the factory
+	// must not also start them or they'd be started twice.
+	fmt.Println("Starting simple controller...")
+	return c.Run(ctx, 2)
+}